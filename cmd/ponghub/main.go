@@ -0,0 +1,33 @@
+// Command ponghub runs endpoint checks as a long-running Prometheus exporter,
+// selected with --serve. It has no polling mode: without --serve it exits with
+// an error.
+package main
+
+import (
+	"flag"
+	"log"
+
+	"github.com/wcy-dt/ponghub/internal/exporter"
+	"github.com/wcy-dt/ponghub/internal/types/structures/configure"
+)
+
+func main() {
+	serveAddr := flag.String("serve", "", "listen address for the Prometheus exporter (e.g. :9115); required")
+	eventsFile := flag.String("events-file", "", "NDJSON file to append per-attempt/per-result events to; empty disables the sink")
+	eventsRingSize := flag.Int("events-ring-size", 256, "number of recent events kept in memory for /events replay")
+	timeout := flag.Int("timeout", 10, "per-request timeout in seconds")
+	maxRetryTimes := flag.Int("max-retry-times", 3, "maximum number of attempts per check")
+	flag.Parse()
+
+	if *serveAddr == "" {
+		// This build only wires the exporter entrypoint; the YAML-config-driven
+		// polling loop lives elsewhere and isn't part of this package.
+		log.Fatalln("ponghub: polling mode is not available in this command; pass --serve <addr> to run the Prometheus exporter")
+	}
+
+	modules := map[string]*configure.Endpoint{}
+	server := exporter.NewServer(*serveAddr, *timeout, *maxRetryTimes, modules, *eventsRingSize, *eventsFile)
+	if err := server.ListenAndServe(); err != nil {
+		log.Fatalln("ponghub: exporter stopped:", err)
+	}
+}
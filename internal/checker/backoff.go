@@ -0,0 +1,96 @@
+package checker
+
+import (
+	"errors"
+	"math/rand"
+	"net"
+	"time"
+
+	"github.com/wcy-dt/ponghub/internal/types/structures/configure"
+)
+
+const (
+	defaultInitialDelay = 100 * time.Millisecond
+	defaultMaxDelay     = 30 * time.Second
+	defaultMultiplier   = 2.0
+)
+
+// nextBackoff computes the sleep before the next retry attempt using
+// decorrelated-jitter backoff: sleep = min(max_delay, random_between(initial_delay,
+// prev_sleep*3)), seeded from initial_delay on the first call (prevSleep == 0).
+// policy.Jitter narrows this to "equal" (half fixed, half random) or "none"
+// (no randomness at all) for callers that want less spread.
+func nextBackoff(policy configure.RetryPolicy, prevSleep time.Duration) time.Duration {
+	initialDelay := policy.InitialDelay.Duration()
+	if initialDelay <= 0 {
+		initialDelay = defaultInitialDelay
+	}
+	maxDelay := policy.MaxDelay.Duration()
+	if maxDelay <= 0 {
+		maxDelay = defaultMaxDelay
+	}
+	multiplier := policy.Multiplier
+	if multiplier <= 0 {
+		multiplier = defaultMultiplier
+	}
+	if prevSleep <= 0 {
+		prevSleep = initialDelay
+	}
+
+	var sleep time.Duration
+	switch policy.Jitter {
+	case configure.JitterNone:
+		sleep = time.Duration(float64(prevSleep) * multiplier)
+	case configure.JitterEqual:
+		half := time.Duration(float64(prevSleep) * multiplier / 2)
+		sleep = half + randDuration(half)
+	default: // JitterFull, or unset: full decorrelated jitter
+		upper := time.Duration(float64(prevSleep) * 3)
+		if upper <= initialDelay {
+			sleep = initialDelay
+		} else {
+			sleep = initialDelay + randDuration(upper-initialDelay)
+		}
+	}
+
+	if sleep > maxDelay {
+		sleep = maxDelay
+	}
+	if sleep < 0 {
+		sleep = 0
+	}
+	return sleep
+}
+
+// randDuration returns a random duration in [0, n]
+func randDuration(n time.Duration) time.Duration {
+	if n <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(n) + 1))
+}
+
+// shouldRetry reports whether a failed attempt is worth retrying under policy.
+// A zero-value policy (no status ranges, network, or timeout classes configured)
+// retries everything, matching the historical back-to-back retry behavior.
+func shouldRetry(policy configure.RetryPolicy, statusCode int, attemptErr error) bool {
+	retryOn := policy.RetryOn
+	if len(retryOn.StatusRanges) == 0 && !retryOn.Network && !retryOn.Timeout {
+		return true
+	}
+
+	if attemptErr != nil {
+		var netErr net.Error
+		if errors.As(attemptErr, &netErr) && netErr.Timeout() {
+			return retryOn.Timeout
+		}
+		return retryOn.Network
+	}
+
+	for _, r := range retryOn.StatusRanges {
+		if statusCode >= r.Min && statusCode <= r.Max {
+			return true
+		}
+	}
+	return false
+}
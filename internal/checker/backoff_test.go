@@ -0,0 +1,93 @@
+package checker
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/wcy-dt/ponghub/internal/types/structures/configure"
+)
+
+func TestNextBackoffRespectsMaxDelay(t *testing.T) {
+	policy := configure.RetryPolicy{
+		InitialDelay: configure.Duration(10 * time.Millisecond),
+		MaxDelay:     configure.Duration(50 * time.Millisecond),
+		Multiplier:   2,
+	}
+
+	prev := time.Duration(0)
+	for i := 0; i < 20; i++ {
+		prev = nextBackoff(policy, prev)
+		if prev > policy.MaxDelay.Duration() {
+			t.Fatalf("nextBackoff returned %v, want <= max_delay %v", prev, policy.MaxDelay.Duration())
+		}
+		if prev < 0 {
+			t.Fatalf("nextBackoff returned negative duration %v", prev)
+		}
+	}
+}
+
+func TestNextBackoffDefaultsWhenPolicyIsZeroValue(t *testing.T) {
+	sleep := nextBackoff(configure.RetryPolicy{}, 0)
+	if sleep < 0 || sleep > defaultMaxDelay {
+		t.Fatalf("nextBackoff with zero-value policy returned %v, want within [0, %v]", sleep, defaultMaxDelay)
+	}
+}
+
+func TestNextBackoffJitterNoneIsDeterministic(t *testing.T) {
+	policy := configure.RetryPolicy{
+		InitialDelay: configure.Duration(10 * time.Millisecond),
+		MaxDelay:     configure.Duration(time.Second),
+		Multiplier:   2,
+		Jitter:       configure.JitterNone,
+	}
+
+	got := nextBackoff(policy, 10*time.Millisecond)
+	want := 20 * time.Millisecond
+	if got != want {
+		t.Fatalf("nextBackoff with JitterNone = %v, want %v", got, want)
+	}
+}
+
+func TestShouldRetryZeroValuePolicyRetriesEverything(t *testing.T) {
+	if !shouldRetry(configure.RetryPolicy{}, 500, nil) {
+		t.Fatal("zero-value policy should retry on a 500 status")
+	}
+	if !shouldRetry(configure.RetryPolicy{}, 0, errors.New("boom")) {
+		t.Fatal("zero-value policy should retry on a generic error")
+	}
+}
+
+func TestShouldRetryHonorsStatusRanges(t *testing.T) {
+	policy := configure.RetryPolicy{
+		RetryOn: configure.RetryOn{
+			StatusRanges: []configure.StatusRange{{Min: 500, Max: 599}},
+		},
+	}
+
+	if !shouldRetry(policy, 503, nil) {
+		t.Fatal("503 is within the configured status range and should be retried")
+	}
+	if shouldRetry(policy, 404, nil) {
+		t.Fatal("404 is outside the configured status range and should not be retried")
+	}
+}
+
+func TestShouldRetryHonorsNetworkAndTimeoutFlags(t *testing.T) {
+	policy := configure.RetryPolicy{
+		RetryOn: configure.RetryOn{Network: true},
+	}
+
+	if !shouldRetry(policy, 0, errors.New("connection refused")) {
+		t.Fatal("Network:true should retry a plain network error")
+	}
+
+	timeoutPolicy := configure.RetryPolicy{RetryOn: configure.RetryOn{Timeout: true}}
+	if !shouldRetry(timeoutPolicy, 0, &net.DNSError{IsTimeout: true}) {
+		t.Fatal("Timeout:true should retry a timeout net.Error")
+	}
+	if shouldRetry(timeoutPolicy, 0, &net.DNSError{IsTimeout: false}) {
+		t.Fatal("Timeout:true should not retry a non-timeout net.Error")
+	}
+}
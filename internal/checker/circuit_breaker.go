@@ -0,0 +1,111 @@
+package checker
+
+import (
+	"sync"
+	"time"
+
+	"github.com/wcy-dt/ponghub/internal/types/structures/configure"
+)
+
+// Breaker states, serialized as-is so they survive a process restart
+const (
+	BreakerClosed   = "closed"
+	BreakerOpen     = "open"
+	BreakerHalfOpen = "half_open"
+)
+
+// BreakerState is the serializable snapshot of one URL's circuit breaker
+type BreakerState struct {
+	URL                 string    `json:"url"`
+	State               string    `json:"state"`
+	ConsecutiveFailures int       `json:"consecutive_failures"`
+	OpenedAt            time.Time `json:"opened_at,omitempty"`
+}
+
+var (
+	breakersMu sync.Mutex
+	breakers   = map[string]*BreakerState{}
+)
+
+// LoadBreakerStates seeds the in-memory breaker registry, keyed by URL. Callers
+// (typically the logger package, on startup) use this to restore breaker state
+// that was persisted on a previous run.
+func LoadBreakerStates(states []BreakerState) {
+	breakersMu.Lock()
+	defer breakersMu.Unlock()
+	for i := range states {
+		s := states[i]
+		breakers[s.URL] = &s
+	}
+}
+
+// SnapshotBreakerStates returns the current breaker registry for persistence
+func SnapshotBreakerStates() []BreakerState {
+	breakersMu.Lock()
+	defer breakersMu.Unlock()
+
+	out := make([]BreakerState, 0, len(breakers))
+	for _, s := range breakers {
+		out = append(out, *s)
+	}
+	return out
+}
+
+// breakerAllows reports whether url's breaker currently permits a probe. A
+// FailureThreshold <= 0 opts the endpoint out of circuit breaking entirely. An
+// open breaker transitions to half-open (and allows a single probe through)
+// once policy.OpenDuration has elapsed since it tripped.
+func breakerAllows(url string, policy configure.RetryPolicy) bool {
+	if policy.FailureThreshold <= 0 {
+		return true
+	}
+
+	breakersMu.Lock()
+	defer breakersMu.Unlock()
+
+	s, ok := breakers[url]
+	if !ok || s.State != BreakerOpen {
+		return true
+	}
+	if time.Since(s.OpenedAt) < policy.OpenDuration.Duration() {
+		return false
+	}
+
+	s.State = BreakerHalfOpen
+	return true
+}
+
+// breakerRecordSuccess closes url's breaker after a successful probe, including
+// the single probe a half-open breaker allows through
+func breakerRecordSuccess(url string) {
+	breakersMu.Lock()
+	defer breakersMu.Unlock()
+	if s, ok := breakers[url]; ok {
+		s.State = BreakerClosed
+		s.ConsecutiveFailures = 0
+	}
+}
+
+// breakerRecordFailure tracks a failed probe, opening the breaker once
+// policy.FailureThreshold consecutive failures have accumulated. A failure
+// while half-open immediately re-opens it.
+func breakerRecordFailure(url string, policy configure.RetryPolicy) {
+	if policy.FailureThreshold <= 0 {
+		return
+	}
+
+	breakersMu.Lock()
+	defer breakersMu.Unlock()
+
+	s, ok := breakers[url]
+	if !ok {
+		s = &BreakerState{URL: url, State: BreakerClosed}
+		breakers[url] = s
+	}
+
+	s.ConsecutiveFailures++
+	if s.State == BreakerHalfOpen || s.ConsecutiveFailures >= policy.FailureThreshold {
+		s.State = BreakerOpen
+		s.OpenedAt = time.Now()
+	}
+}
@@ -0,0 +1,95 @@
+package checker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wcy-dt/ponghub/internal/types/structures/configure"
+)
+
+// resetBreakers clears the package-level breaker registry between tests, since
+// breakerAllows/breakerRecordFailure/breakerRecordSuccess operate on shared state
+func resetBreakers(t *testing.T) {
+	t.Helper()
+	breakersMu.Lock()
+	breakers = map[string]*BreakerState{}
+	breakersMu.Unlock()
+}
+
+func TestBreakerAllowsWhenFailureThresholdDisabled(t *testing.T) {
+	resetBreakers(t)
+	if !breakerAllows("https://example.com", configure.RetryPolicy{}) {
+		t.Fatal("a zero FailureThreshold should always allow the probe")
+	}
+}
+
+func TestBreakerOpensAfterFailureThreshold(t *testing.T) {
+	resetBreakers(t)
+	policy := configure.RetryPolicy{FailureThreshold: 3, OpenDuration: configure.Duration(time.Hour)}
+	url := "https://example.com"
+
+	for i := 0; i < 2; i++ {
+		breakerRecordFailure(url, policy)
+		if !breakerAllows(url, policy) {
+			t.Fatalf("breaker should still be closed after %d failures", i+1)
+		}
+	}
+
+	breakerRecordFailure(url, policy)
+	if breakerAllows(url, policy) {
+		t.Fatal("breaker should be open after reaching FailureThreshold")
+	}
+}
+
+func TestBreakerTransitionsToHalfOpenAfterOpenDuration(t *testing.T) {
+	resetBreakers(t)
+	policy := configure.RetryPolicy{FailureThreshold: 1, OpenDuration: configure.Duration(time.Hour)}
+	url := "https://example.com"
+
+	breakerRecordFailure(url, policy)
+	if breakerAllows(url, policy) {
+		t.Fatal("breaker should be open immediately after tripping")
+	}
+
+	// Simulate OpenDuration having elapsed without sleeping in real time, so the
+	// assertion can't flake under scheduler/GC delay.
+	breakersMu.Lock()
+	breakers[url].OpenedAt = time.Now().Add(-2 * policy.OpenDuration.Duration())
+	breakersMu.Unlock()
+
+	if !breakerAllows(url, policy) {
+		t.Fatal("breaker should allow a single half-open probe once OpenDuration has elapsed")
+	}
+}
+
+func TestBreakerRecordSuccessClosesBreaker(t *testing.T) {
+	resetBreakers(t)
+	policy := configure.RetryPolicy{FailureThreshold: 1, OpenDuration: configure.Duration(time.Hour)}
+	url := "https://example.com"
+
+	breakerRecordFailure(url, policy)
+	breakersMu.Lock()
+	breakers[url].OpenedAt = time.Now().Add(-2 * policy.OpenDuration.Duration())
+	breakersMu.Unlock()
+	if !breakerAllows(url, policy) {
+		t.Fatal("breaker should be half-open and allow a probe")
+	}
+
+	breakerRecordSuccess(url)
+	if !breakerAllows(url, policy) {
+		t.Fatal("breaker should be closed after a recorded success")
+	}
+}
+
+func TestLoadAndSnapshotBreakerStatesRoundTrip(t *testing.T) {
+	resetBreakers(t)
+	want := []BreakerState{
+		{URL: "https://a.example", State: BreakerOpen, ConsecutiveFailures: 5, OpenedAt: time.Now()},
+	}
+
+	LoadBreakerStates(want)
+	got := SnapshotBreakerStates()
+	if len(got) != 1 || got[0].URL != want[0].URL || got[0].State != want[0].State {
+		t.Fatalf("SnapshotBreakerStates() = %+v, want %+v", got, want)
+	}
+}
@@ -1,7 +1,7 @@
 package checker
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"io"
 	"log"
@@ -13,10 +13,14 @@ import (
 	"github.com/wcy-dt/ponghub/internal/common/params"
 	"github.com/wcy-dt/ponghub/internal/types/structures/checker"
 	"github.com/wcy-dt/ponghub/internal/types/structures/configure"
+	"github.com/wcy-dt/ponghub/internal/types/types/chk_result"
 )
 
-// checkEndpoint checks a single port based on the provided configuration
-func checkEndpoint(cfg *configure.Endpoint, timeout int, maxRetryTimes int, serviceName string) checker.Endpoint {
+// httpProber is the default Prober, issuing plain HTTP(S) requests
+type httpProber struct{}
+
+// Probe checks a single endpoint over HTTP based on the provided configuration
+func (httpProber) Probe(ctx context.Context, cfg *configure.Endpoint, timeout int, maxRetryTimes int, serviceName string) checker.Endpoint {
 	var failureDetails []string
 	successNum := 0
 	attemptNum := 0
@@ -24,7 +28,21 @@ func checkEndpoint(cfg *configure.Endpoint, timeout int, maxRetryTimes int, serv
 	var statusCode int
 	var responseBody string
 
-	httpMethod := getHttpMethod(cfg.Method)
+	httpMethod, err := getHttpMethod(cfg.Method)
+	if err != nil {
+		now := time.Now()
+		return checker.Endpoint{
+			URL:            cfg.URL,
+			Method:         strings.ToUpper(cfg.Method),
+			Body:           cfg.Body,
+			Status:         getTestResult(0, 1),
+			StartTime:      now.Format(time.RFC3339),
+			EndTime:        now.Format(time.RFC3339),
+			AttemptNum:     1,
+			SuccessNum:     0,
+			FailureDetails: []string{err.Error()},
+		}
+	}
 	maxResponseTime := time.Duration(0)
 
 	// SSL certificate related variables
@@ -43,6 +61,8 @@ func checkEndpoint(cfg *configure.Endpoint, timeout int, maxRetryTimes int, serv
 	}
 
 	// Check SSL certificate if it's an HTTPS URL
+	var tlsInfo *checker.TLSInfo
+	tlsDegraded := false
 	if urlIsHTTPS {
 		remainingDays, expired, err := checkSSLCertificates(cfg.ParsedURL)
 		if err != nil {
@@ -55,11 +75,44 @@ func checkEndpoint(cfg *configure.Endpoint, timeout int, maxRetryTimes int, serv
 			isCertExpired = expired
 			// Only log success details during tests to avoid exposing secrets
 			logIfTest("SSL Certificate Info for %s: %d days remaining, expired: %v", cfg.ParsedURL, remainingDays, expired)
+
+			info, warn, postureErr := inspectTLSChain(cfg.ParsedURL, cfg)
+			if postureErr != nil {
+				// A posture violation (failed pin, issuer/SAN mismatch, min_version too
+				// low) fails the endpoint outright, the same way an invalid method does -
+				// it must not be masked by an otherwise-successful HTTP response.
+				logIfTest("TLS posture check failed for %s: %v", cfg.ParsedURL, postureErr)
+				now := time.Now()
+				return checker.Endpoint{
+					URL:               cfg.URL,
+					Method:            httpMethod,
+					Body:              cfg.Body,
+					Status:            getTestResult(0, 1),
+					StartTime:         now.Format(time.RFC3339),
+					EndTime:           now.Format(time.RFC3339),
+					AttemptNum:        1,
+					SuccessNum:        0,
+					FailureDetails:    []string{fmt.Sprintf("TLS Posture Error: %s", postureErr.Error())},
+					IsHTTPS:           urlIsHTTPS,
+					CertRemainingDays: certRemainingDays,
+					IsCertExpired:     isCertExpired,
+					DisplayURL:        displayURL,
+					HighlightSegments: highlightSegments,
+				}
+			}
+			tlsInfo = &info
+			tlsDegraded = warn
 		}
 	}
 
+	lastSleep := time.Duration(0)
 	startTime := time.Now()
 	for currentAttemptNum := range maxRetryTimes {
+		if currentAttemptNum > 0 {
+			lastSleep = nextBackoff(cfg.RetryPolicy, lastSleep)
+			time.Sleep(lastSleep)
+		}
+
 		attemptNum++
 		client := &http.Client{
 			Timeout: time.Duration(timeout) * time.Second,
@@ -69,10 +122,14 @@ func checkEndpoint(cfg *configure.Endpoint, timeout int, maxRetryTimes int, serv
 			serviceName, httpMethod, cfg.ParsedURL, currentAttemptNum+1, maxRetryTimes)
 
 		// build the request
-		req, err := http.NewRequest(httpMethod, cfg.ParsedURL, nil)
+		req, err := http.NewRequestWithContext(ctx, httpMethod, cfg.ParsedURL, nil)
 		if err != nil {
 			failureDetails = append(failureDetails, fmt.Sprintf("StatusCode: N/A, Error: %s", err.Error()))
 			log.Printf("FAILED - Error: %s", err.Error())
+			publishAttempt(serviceName, cfg.URL, attemptNum, 0, 0, certRemainingDays, failureDetails[len(failureDetails)-1:])
+			if !shouldRetry(cfg.RetryPolicy, 0, err) {
+				break
+			}
 			continue
 		}
 		for headerName, headerValue := range cfg.ParsedHeaders {
@@ -89,16 +146,24 @@ func checkEndpoint(cfg *configure.Endpoint, timeout int, maxRetryTimes int, serv
 		if err != nil {
 			failureDetails = append(failureDetails, fmt.Sprintf("StatusCode: N/A, Error: %s", err.Error()))
 			log.Printf("FAILED - Error: %s", err.Error())
+			publishAttempt(serviceName, cfg.URL, attemptNum, 0, responseTime, certRemainingDays, failureDetails[len(failureDetails)-1:])
+			if !shouldRetry(cfg.RetryPolicy, 0, err) {
+				break
+			}
 			continue
 		}
 		body, err := io.ReadAll(resp.Body)
 		if err != nil {
 			failureDetails = append(failureDetails, fmt.Sprintf("StatusCode: %d, Error: %s", resp.StatusCode, err.Error()))
 			log.Printf("FAILED - StatusCode: %d, Error: %s", resp.StatusCode, err.Error())
+			publishAttempt(serviceName, cfg.URL, attemptNum, resp.StatusCode, responseTime, certRemainingDays, failureDetails[len(failureDetails)-1:])
 			if err := resp.Body.Close(); err != nil {
 				// Only log response body errors during tests to avoid exposing secrets
 				logIfTest("Error closing response body for %s: %v", cfg.ParsedURL, err)
 			}
+			if !shouldRetry(cfg.RetryPolicy, resp.StatusCode, err) {
+				break
+			}
 			continue
 		}
 		responseBody = string(body)
@@ -119,22 +184,33 @@ func checkEndpoint(cfg *configure.Endpoint, timeout int, maxRetryTimes int, serv
 			// Only log success details during tests to avoid exposing secrets
 			logIfTest("SUCCESS - %s %s (attempt %d/%d) - Response Time: %d ms, Status Code: %d",
 				httpMethod, cfg.ParsedURL, currentAttemptNum+1, maxRetryTimes, responseTime.Milliseconds(), resp.StatusCode)
+			publishAttempt(serviceName, cfg.URL, attemptNum, resp.StatusCode, responseTime, certRemainingDays, nil)
 			break
 		}
 		failureDetails = append(failureDetails, fmt.Sprintf("StatusCode or ResponseRegex mismatch: %d", resp.StatusCode))
 		log.Printf("FAILED - StatusCode or ResponseRegex mismatch: %d", resp.StatusCode)
+		publishAttempt(serviceName, cfg.URL, attemptNum, resp.StatusCode, responseTime, certRemainingDays, failureDetails[len(failureDetails)-1:])
 		if err := resp.Body.Close(); err != nil {
 			// Only log response body errors during tests to avoid exposing secrets
 			logIfTest("Error closing response body for %s: %v", cfg.ParsedURL, err)
 		}
+		if !shouldRetry(cfg.RetryPolicy, resp.StatusCode, nil) {
+			break
+		}
 	}
 	endTime := time.Now()
 
+	status := getTestResult(successNum, attemptNum)
+	if tlsDegraded && status == chk_result.ALL {
+		// Certificate is within tls.warn_days of expiring: degrade rather than fail outright
+		status = chk_result.PART
+	}
+
 	return checker.Endpoint{
 		URL:               cfg.URL,
 		Method:            httpMethod,
 		Body:              cfg.Body,
-		Status:            getTestResult(successNum, attemptNum),
+		Status:            status,
 		StatusCode:        statusCode,
 		StartTime:         startTime.Format(time.RFC3339),
 		EndTime:           endTime.Format(time.RFC3339),
@@ -146,36 +222,36 @@ func checkEndpoint(cfg *configure.Endpoint, timeout int, maxRetryTimes int, serv
 		IsHTTPS:           urlIsHTTPS,
 		CertRemainingDays: certRemainingDays,
 		IsCertExpired:     isCertExpired,
+		TLSInfo:           tlsInfo,
 		DisplayURL:        displayURL,
 		HighlightSegments: highlightSegments,
 	}
 }
 
-// getHttpMethod converts a string method to an HTTP method constant
-func getHttpMethod(method string) string {
+// getHttpMethod converts a string method to an HTTP method constant. Methods that
+// cannot be probed safely (TRACE, CONNECT) are reported as an error instead of
+// killing the whole process, so a single config typo can't take down every check.
+func getHttpMethod(method string) (string, error) {
 	switch strings.ToUpper(method) {
-	case "GET":
-		return http.MethodGet
+	case "", "GET":
+		return http.MethodGet, nil
 	case "POST":
-		return http.MethodPost
+		return http.MethodPost, nil
 	case "PUT":
-		return http.MethodPut
-	case "DELETE":
-		log.Fatalln(errors.New("method not supported"))
+		return http.MethodPut, nil
 	case "HEAD":
-		log.Fatalln(errors.New("method not supported"))
+		return http.MethodHead, nil
 	case "PATCH":
-		log.Fatalln(errors.New("method not supported"))
+		return http.MethodPatch, nil
+	case "DELETE":
+		return http.MethodDelete, nil
 	case "OPTIONS":
-		log.Fatalln(errors.New("method not supported"))
-	case "TRACE":
-		log.Fatalln(errors.New("method not supported"))
-	case "CONNECT":
-		log.Fatalln(errors.New("method not supported"))
+		return http.MethodOptions, nil
+	case "TRACE", "CONNECT":
+		return "", fmt.Errorf("method not supported: %s", method)
 	default:
-		return http.MethodGet // Default to GET if method is unknown
+		return http.MethodGet, nil // Default to GET if method is unknown
 	}
-	return http.MethodGet
 }
 
 // isSuccessfulResponse checks if the response from the server is successful based on the configuration
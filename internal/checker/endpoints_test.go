@@ -0,0 +1,44 @@
+package checker
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetHttpMethod(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		want    string
+		wantErr bool
+	}{
+		{name: "empty defaults to GET", method: "", want: http.MethodGet},
+		{name: "get", method: "get", want: http.MethodGet},
+		{name: "post", method: "POST", want: http.MethodPost},
+		{name: "put", method: "put", want: http.MethodPut},
+		{name: "head", method: "HEAD", want: http.MethodHead},
+		{name: "patch", method: "patch", want: http.MethodPatch},
+		{name: "delete", method: "DELETE", want: http.MethodDelete},
+		{name: "options", method: "options", want: http.MethodOptions},
+		{name: "trace is rejected", method: "TRACE", wantErr: true},
+		{name: "connect is rejected", method: "CONNECT", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getHttpMethod(tt.method)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("getHttpMethod(%q) expected an error, got nil", tt.method)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("getHttpMethod(%q) returned unexpected error: %v", tt.method, err)
+			}
+			if got != tt.want {
+				t.Fatalf("getHttpMethod(%q) = %q, want %q", tt.method, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package checker
+
+import (
+	"time"
+
+	"github.com/wcy-dt/ponghub/internal/events"
+	"github.com/wcy-dt/ponghub/internal/types/structures/checker"
+)
+
+// eventBus receives per-attempt and per-endpoint events, if configured
+var eventBus *events.Bus
+
+// SetEventBus registers the bus that check results are published to. Passing
+// nil (the default) disables publishing entirely.
+func SetEventBus(bus *events.Bus) {
+	eventBus = bus
+}
+
+// publishAttempt emits a single probe attempt's outcome to the configured event bus
+func publishAttempt(serviceName, url string, attempt int, statusCode int, responseTime time.Duration, certRemainingDays int, failureDetails []string) {
+	if eventBus == nil {
+		return
+	}
+	eventBus.Publish(events.Event{
+		Service:           serviceName,
+		URL:               url,
+		Attempt:           attempt,
+		StatusCode:        statusCode,
+		ResponseTimeMs:    responseTime.Milliseconds(),
+		CertRemainingDays: certRemainingDays,
+		FailureDetails:    failureDetails,
+	})
+}
+
+// publishResult emits the final, merged outcome of a check to the configured event bus
+func publishResult(serviceName string, result checker.Endpoint) {
+	if eventBus == nil {
+		return
+	}
+	eventBus.Publish(events.Event{
+		Service:           serviceName,
+		URL:               result.URL,
+		Attempt:           result.AttemptNum,
+		StatusCode:        result.StatusCode,
+		ResponseTimeMs:    result.ResponseTime.Milliseconds(),
+		CertRemainingDays: result.CertRemainingDays,
+		FailureDetails:    result.FailureDetails,
+		Final:             true,
+	})
+}
@@ -0,0 +1,79 @@
+package checker
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/wcy-dt/ponghub/internal/types/structures/checker"
+	"github.com/wcy-dt/ponghub/internal/types/structures/configure"
+	"github.com/wcy-dt/ponghub/internal/types/types/chk_result"
+)
+
+// Prober probes a single endpoint using a protocol-specific strategy and returns
+// the check result. configure.Endpoint.Type selects which Prober handles a given
+// endpoint ("tcp", "icmp", "dns", "grpc"); an empty or unrecognized type falls
+// back to the HTTP prober, so existing YAML configs keep working unchanged.
+type Prober interface {
+	Probe(ctx context.Context, cfg *configure.Endpoint, timeout int, maxRetryTimes int, serviceName string) checker.Endpoint
+}
+
+var probers = map[string]Prober{
+	"http": httpProber{},
+	"tcp":  tcpProber{},
+	"icmp": icmpProber{},
+	"dns":  dnsProber{},
+	"grpc": grpcProber{},
+}
+
+// CheckEndpoint runs a single on-demand check against cfg and returns its result.
+// It is the exported entry point used by callers outside this package, such as the
+// Prometheus exporter, that need to trigger a check without going through the
+// regular polling cycle.
+func CheckEndpoint(cfg *configure.Endpoint, timeout int, maxRetryTimes int, serviceName string) checker.Endpoint {
+	return checkEndpoint(cfg, timeout, maxRetryTimes, serviceName)
+}
+
+// checkEndpoint dispatches cfg to the Prober selected by cfg.Type, honoring the
+// endpoint's circuit breaker, then publishes the merged result to the event bus
+// so live subscribers don't have to poll.
+func checkEndpoint(cfg *configure.Endpoint, timeout int, maxRetryTimes int, serviceName string) checker.Endpoint {
+	if !breakerAllows(cfg.URL, cfg.RetryPolicy) {
+		result := skippedResult(cfg)
+		publishResult(serviceName, result)
+		return result
+	}
+
+	result := selectProber(cfg.Type).Probe(context.Background(), cfg, timeout, maxRetryTimes, serviceName)
+	if result.SuccessNum > 0 {
+		breakerRecordSuccess(cfg.URL)
+	} else {
+		breakerRecordFailure(cfg.URL, cfg.RetryPolicy)
+	}
+
+	publishResult(serviceName, result)
+	return result
+}
+
+// skippedResult builds the result returned when a circuit breaker is open and
+// the configured Prober is not invoked at all
+func skippedResult(cfg *configure.Endpoint) checker.Endpoint {
+	now := time.Now()
+	return checker.Endpoint{
+		URL:            cfg.URL,
+		Method:         strings.ToUpper(cfg.Method),
+		Body:           cfg.Body,
+		Status:         chk_result.SKIPPED,
+		StartTime:      now.Format(time.RFC3339),
+		EndTime:        now.Format(time.RFC3339),
+		FailureDetails: []string{"circuit breaker open: skipping probe"},
+	}
+}
+
+// selectProber resolves cfg.Type to a registered Prober, defaulting to HTTP
+func selectProber(probeType string) Prober {
+	if p, ok := probers[strings.ToLower(probeType)]; ok {
+		return p
+	}
+	return httpProber{}
+}
@@ -0,0 +1,109 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/wcy-dt/ponghub/internal/types/structures/checker"
+	"github.com/wcy-dt/ponghub/internal/types/structures/configure"
+)
+
+// dnsProber resolves cfg.ParsedURL against a configured resolver and, if
+// cfg.ResponseRegex is set, requires at least one returned address to match it.
+type dnsProber struct{}
+
+// Probe looks up cfg.ParsedURL and checks the answers against cfg.ResponseRegex
+func (dnsProber) Probe(ctx context.Context, cfg *configure.Endpoint, timeout int, maxRetryTimes int, serviceName string) checker.Endpoint {
+	var failureDetails []string
+	successNum, attemptNum := 0, 0
+	maxResponseTime := time.Duration(0)
+	var responseBody string
+
+	resolver := net.DefaultResolver
+	if cfg.Resolver != "" {
+		resolver = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				return (&net.Dialer{Timeout: time.Duration(timeout) * time.Second}).DialContext(ctx, network, cfg.Resolver)
+			},
+		}
+	}
+
+	lastSleep := time.Duration(0)
+	startTime := time.Now()
+	for currentAttemptNum := range maxRetryTimes {
+		if currentAttemptNum > 0 {
+			lastSleep = nextBackoff(cfg.RetryPolicy, lastSleep)
+			time.Sleep(lastSleep)
+		}
+
+		attemptNum++
+		logIfTest("[%s] DNS lookup %s (attempt %d/%d)", serviceName, cfg.ParsedURL, currentAttemptNum+1, maxRetryTimes)
+
+		lookupCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+		reqStartTime := time.Now()
+		addrs, err := resolver.LookupHost(lookupCtx, cfg.ParsedURL)
+		responseTime := time.Since(reqStartTime)
+		cancel()
+		if err != nil {
+			failureDetails = append(failureDetails, fmt.Sprintf("DNS lookup error: %s", err.Error()))
+			log.Printf("FAILED - DNS lookup error: %s", err.Error())
+			publishAttempt(serviceName, cfg.URL, attemptNum, 0, responseTime, 0, failureDetails[len(failureDetails)-1:])
+			if !shouldRetry(cfg.RetryPolicy, 0, err) {
+				break
+			}
+			continue
+		}
+		responseBody = strings.Join(addrs, ", ")
+
+		if cfg.ResponseRegex != "" {
+			matched := false
+			for _, addr := range addrs {
+				addrMatched, err := regexp.MatchString(cfg.ResponseRegex, addr)
+				if err != nil {
+					log.Fatalln("Error parsing regexp:", err)
+				}
+				if addrMatched {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				failureDetails = append(failureDetails, fmt.Sprintf("ResponseRegex mismatch: %s", responseBody))
+				log.Printf("FAILED - ResponseRegex mismatch: %s", responseBody)
+				publishAttempt(serviceName, cfg.URL, attemptNum, 0, responseTime, 0, failureDetails[len(failureDetails)-1:])
+				if !shouldRetry(cfg.RetryPolicy, 0, nil) {
+					break
+				}
+				continue
+			}
+		}
+
+		successNum++
+		if responseTime > maxResponseTime {
+			maxResponseTime = responseTime
+		}
+		logIfTest("SUCCESS - DNS %s (attempt %d/%d) - Answers: %s", cfg.ParsedURL, currentAttemptNum+1, maxRetryTimes, responseBody)
+		publishAttempt(serviceName, cfg.URL, attemptNum, 0, responseTime, 0, nil)
+		break
+	}
+	endTime := time.Now()
+
+	return checker.Endpoint{
+		URL:            cfg.URL,
+		Method:         "DNS",
+		Status:         getTestResult(successNum, attemptNum),
+		StartTime:      startTime.Format(time.RFC3339),
+		EndTime:        endTime.Format(time.RFC3339),
+		ResponseTime:   maxResponseTime,
+		AttemptNum:     attemptNum,
+		SuccessNum:     successNum,
+		FailureDetails: failureDetails,
+		ResponseBody:   responseBody,
+	}
+}
@@ -0,0 +1,38 @@
+package checker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/wcy-dt/ponghub/internal/types/structures/configure"
+	"github.com/wcy-dt/ponghub/internal/types/types/chk_result"
+)
+
+func TestDNSProberSucceedsResolvingLocalhost(t *testing.T) {
+	cfg := &configure.Endpoint{URL: "localhost", ParsedURL: "localhost", Type: "dns"}
+	result := dnsProber{}.Probe(context.Background(), cfg, 1, 1, "test-service")
+
+	if result.Status != chk_result.ALL {
+		t.Fatalf("Status = %v, want %v; failures: %v", result.Status, chk_result.ALL, result.FailureDetails)
+	}
+	if result.ResponseBody == "" {
+		t.Fatal("expected ResponseBody to list the resolved addresses")
+	}
+}
+
+func TestDNSProberFailsWhenResponseRegexDoesNotMatch(t *testing.T) {
+	cfg := &configure.Endpoint{
+		URL:           "localhost",
+		ParsedURL:     "localhost",
+		Type:          "dns",
+		ResponseRegex: "this-will-never-match-an-ip",
+	}
+	result := dnsProber{}.Probe(context.Background(), cfg, 1, 1, "test-service")
+
+	if result.Status == chk_result.ALL {
+		t.Fatal("a non-matching ResponseRegex should not report success")
+	}
+	if len(result.FailureDetails) == 0 {
+		t.Fatal("expected a failure detail describing the regex mismatch")
+	}
+}
@@ -0,0 +1,101 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/wcy-dt/ponghub/internal/types/structures/checker"
+	"github.com/wcy-dt/ponghub/internal/types/structures/configure"
+)
+
+// grpcProber checks liveness via the standard grpc.health.v1.Health/Check RPC
+type grpcProber struct{}
+
+// Probe dials cfg.ParsedURL and asserts the health service reports SERVING for cfg.Service
+func (grpcProber) Probe(ctx context.Context, cfg *configure.Endpoint, timeout int, maxRetryTimes int, serviceName string) checker.Endpoint {
+	var failureDetails []string
+	successNum, attemptNum := 0, 0
+	maxResponseTime := time.Duration(0)
+
+	lastSleep := time.Duration(0)
+	startTime := time.Now()
+	for currentAttemptNum := range maxRetryTimes {
+		if currentAttemptNum > 0 {
+			lastSleep = nextBackoff(cfg.RetryPolicy, lastSleep)
+			time.Sleep(lastSleep)
+		}
+
+		attemptNum++
+		logIfTest("[%s] gRPC health check %s (attempt %d/%d)", serviceName, cfg.ParsedURL, currentAttemptNum+1, maxRetryTimes)
+
+		reqStartTime := time.Now()
+		status, err := grpcHealthCheck(ctx, cfg.ParsedURL, cfg.Service, time.Duration(timeout)*time.Second)
+		responseTime := time.Since(reqStartTime)
+		if err != nil {
+			failureDetails = append(failureDetails, fmt.Sprintf("gRPC health check error: %s", err.Error()))
+			log.Printf("FAILED - gRPC health check error: %s", err.Error())
+			publishAttempt(serviceName, cfg.URL, attemptNum, 0, responseTime, 0, failureDetails[len(failureDetails)-1:])
+			if !shouldRetry(cfg.RetryPolicy, 0, err) {
+				break
+			}
+			continue
+		}
+		if status != grpc_health_v1.HealthCheckResponse_SERVING {
+			failureDetails = append(failureDetails, fmt.Sprintf("gRPC health status: %s", status.String()))
+			log.Printf("FAILED - gRPC health status: %s", status.String())
+			publishAttempt(serviceName, cfg.URL, attemptNum, 0, responseTime, 0, failureDetails[len(failureDetails)-1:])
+			if !shouldRetry(cfg.RetryPolicy, 0, nil) {
+				break
+			}
+			continue
+		}
+
+		successNum++
+		if responseTime > maxResponseTime {
+			maxResponseTime = responseTime
+		}
+		logIfTest("SUCCESS - gRPC %s (attempt %d/%d) - Status: %s", cfg.ParsedURL, currentAttemptNum+1, maxRetryTimes, status.String())
+		publishAttempt(serviceName, cfg.URL, attemptNum, 0, responseTime, 0, nil)
+		break
+	}
+	endTime := time.Now()
+
+	return checker.Endpoint{
+		URL:            cfg.URL,
+		Method:         "GRPC",
+		Status:         getTestResult(successNum, attemptNum),
+		StartTime:      startTime.Format(time.RFC3339),
+		EndTime:        endTime.Format(time.RFC3339),
+		ResponseTime:   maxResponseTime,
+		AttemptNum:     attemptNum,
+		SuccessNum:     successNum,
+		FailureDetails: failureDetails,
+	}
+}
+
+// grpcHealthCheck dials target and calls the standard Health/Check RPC for service
+func grpcHealthCheck(ctx context.Context, target, service string, timeout time.Duration) (grpc_health_v1.HealthCheckResponse_ServingStatus, error) {
+	dialCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	conn, err := grpc.DialContext(dialCtx, target,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock(),
+	)
+	if err != nil {
+		return grpc_health_v1.HealthCheckResponse_UNKNOWN, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	resp, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: service})
+	if err != nil {
+		return grpc_health_v1.HealthCheckResponse_UNKNOWN, err
+	}
+	return resp.Status, nil
+}
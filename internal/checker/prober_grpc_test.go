@@ -0,0 +1,29 @@
+package checker
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/wcy-dt/ponghub/internal/types/structures/configure"
+	"github.com/wcy-dt/ponghub/internal/types/types/chk_result"
+)
+
+func TestGRPCProberFailsWhenNothingIsListening(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to allocate a port: %v", err)
+	}
+	addr := ln.Addr().String()
+	_ = ln.Close()
+
+	cfg := &configure.Endpoint{URL: addr, ParsedURL: addr, Type: "grpc", Service: ""}
+	result := grpcProber{}.Probe(context.Background(), cfg, 1, 1, "test-service")
+
+	if result.Status == chk_result.ALL {
+		t.Fatal("dialing a closed port should not report success")
+	}
+	if len(result.FailureDetails) == 0 {
+		t.Fatal("expected a failure detail describing the dial error")
+	}
+}
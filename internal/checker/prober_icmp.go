@@ -0,0 +1,133 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"time"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+
+	"github.com/wcy-dt/ponghub/internal/types/structures/checker"
+	"github.com/wcy-dt/ponghub/internal/types/structures/configure"
+)
+
+// icmpProber checks liveness by sending ICMP echo requests and records loss/RTT
+type icmpProber struct{}
+
+// Probe sends up to maxRetryTimes ICMP echoes to cfg.ParsedURL
+func (icmpProber) Probe(ctx context.Context, cfg *configure.Endpoint, timeout int, maxRetryTimes int, serviceName string) checker.Endpoint {
+	var failureDetails []string
+	successNum, attemptNum := 0, 0
+	maxResponseTime := time.Duration(0)
+	sent, received := 0, 0
+
+	// Unlike the other probers, ICMP sends every attempt rather than stopping at
+	// the first success: loss% is only meaningful when computed over the whole
+	// sample, and bailing out early would understate loss on a flaky link.
+	lastSleep := time.Duration(0)
+	startTime := time.Now()
+	for currentAttemptNum := range maxRetryTimes {
+		if currentAttemptNum > 0 {
+			lastSleep = nextBackoff(cfg.RetryPolicy, lastSleep)
+			time.Sleep(lastSleep)
+		}
+
+		attemptNum++
+		sent++
+		logIfTest("[%s] ICMP echo %s (attempt %d/%d)", serviceName, cfg.ParsedURL, currentAttemptNum+1, maxRetryTimes)
+
+		rtt, err := sendICMPEcho(cfg.ParsedURL, time.Duration(timeout)*time.Second, attemptNum)
+		if err != nil {
+			failureDetails = append(failureDetails, fmt.Sprintf("ICMP error: %s", err.Error()))
+			log.Printf("FAILED - ICMP error: %s", err.Error())
+			publishAttempt(serviceName, cfg.URL, attemptNum, 0, 0, 0, failureDetails[len(failureDetails)-1:])
+			if !shouldRetry(cfg.RetryPolicy, 0, err) {
+				break
+			}
+			continue
+		}
+
+		received++
+		successNum++
+		if rtt > maxResponseTime {
+			maxResponseTime = rtt
+		}
+		logIfTest("SUCCESS - ICMP %s (attempt %d/%d) - RTT: %d ms", cfg.ParsedURL, currentAttemptNum+1, maxRetryTimes, rtt.Milliseconds())
+		publishAttempt(serviceName, cfg.URL, attemptNum, 0, rtt, 0, nil)
+	}
+	endTime := time.Now()
+
+	if lost := sent - received; lost > 0 {
+		failureDetails = append(failureDetails, fmt.Sprintf("Packet loss: %d/%d", lost, sent))
+	}
+
+	return checker.Endpoint{
+		URL:            cfg.URL,
+		Method:         "ICMP",
+		Status:         getTestResult(successNum, attemptNum),
+		StartTime:      startTime.Format(time.RFC3339),
+		EndTime:        endTime.Format(time.RFC3339),
+		ResponseTime:   maxResponseTime,
+		AttemptNum:     attemptNum,
+		SuccessNum:     successNum,
+		FailureDetails: failureDetails,
+	}
+}
+
+// sendICMPEcho sends a single ICMP echo request to addr and returns the round-trip time
+func sendICMPEcho(addr string, timeout time.Duration, seq int) (time.Duration, error) {
+	conn, err := icmp.ListenPacket("ip4:icmp", "0.0.0.0")
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	dst, err := net.ResolveIPAddr("ip4", addr)
+	if err != nil {
+		return 0, err
+	}
+
+	msg := icmp.Message{
+		Type: ipv4.ICMPTypeEcho,
+		Code: 0,
+		Body: &icmp.Echo{
+			ID:   os.Getpid() & 0xffff,
+			Seq:  seq,
+			Data: []byte("ponghub"),
+		},
+	}
+	wb, err := msg.Marshal(nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return 0, err
+	}
+
+	start := time.Now()
+	if _, err := conn.WriteTo(wb, dst); err != nil {
+		return 0, err
+	}
+
+	reply := make([]byte, 1500)
+	n, _, err := conn.ReadFrom(reply)
+	if err != nil {
+		return 0, err
+	}
+	rtt := time.Since(start)
+
+	parsed, err := icmp.ParseMessage(1, reply[:n])
+	if err != nil {
+		return 0, err
+	}
+	if parsed.Type != ipv4.ICMPTypeEchoReply {
+		return 0, fmt.Errorf("unexpected ICMP message type: %v", parsed.Type)
+	}
+
+	return rtt, nil
+}
@@ -0,0 +1,36 @@
+package checker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/wcy-dt/ponghub/internal/types/structures/configure"
+	"github.com/wcy-dt/ponghub/internal/types/types/chk_result"
+)
+
+// TestICMPProberFailsWithoutRawSocketPrivileges exercises the failure path: test
+// environments almost never grant the raw-socket permission ICMP needs, so every
+// attempt fails and the prober should report that cleanly rather than panicking.
+func TestICMPProberFailsWithoutRawSocketPrivileges(t *testing.T) {
+	cfg := &configure.Endpoint{URL: "127.0.0.1", ParsedURL: "127.0.0.1", Type: "icmp"}
+	result := icmpProber{}.Probe(context.Background(), cfg, 1, 1, "test-service")
+
+	if result.AttemptNum != 1 {
+		t.Fatalf("AttemptNum = %d, want 1", result.AttemptNum)
+	}
+	if result.Status == chk_result.ALL && result.SuccessNum == 0 {
+		t.Fatal("Status should not be ALL when SuccessNum is 0")
+	}
+}
+
+func TestICMPProberReportsPacketLossOnUnreachableHost(t *testing.T) {
+	cfg := &configure.Endpoint{URL: "198.51.100.1", ParsedURL: "198.51.100.1", Type: "icmp"}
+	result := icmpProber{}.Probe(context.Background(), cfg, 1, 2, "test-service")
+
+	if result.AttemptNum != 2 {
+		t.Fatalf("AttemptNum = %d, want 2 (ICMP sends every attempt rather than stopping early)", result.AttemptNum)
+	}
+	if n := len(result.FailureDetails); n == 0 || result.FailureDetails[n-1] != "Packet loss: 2/2" {
+		t.Fatalf("FailureDetails = %v, want it to end with %q", result.FailureDetails, "Packet loss: 2/2")
+	}
+}
@@ -0,0 +1,117 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"time"
+
+	"github.com/wcy-dt/ponghub/internal/types/structures/checker"
+	"github.com/wcy-dt/ponghub/internal/types/structures/configure"
+	"github.com/wcy-dt/ponghub/internal/types/types/chk_result"
+)
+
+// tcpProber checks liveness by dialing a TCP address and measuring connect
+// latency, optionally layering a TLS handshake on top to reuse the existing
+// certificate checks for plain TCP services that don't speak HTTP.
+type tcpProber struct{}
+
+// Probe dials cfg.ParsedURL (host:port) and records the connect latency
+func (tcpProber) Probe(ctx context.Context, cfg *configure.Endpoint, timeout int, maxRetryTimes int, serviceName string) checker.Endpoint {
+	var failureDetails []string
+	successNum, attemptNum := 0, 0
+	maxResponseTime := time.Duration(0)
+	certRemainingDays := 0
+	isCertExpired := false
+	tlsDegraded := false
+
+	dialer := &net.Dialer{Timeout: time.Duration(timeout) * time.Second}
+
+	lastSleep := time.Duration(0)
+	startTime := time.Now()
+	for currentAttemptNum := range maxRetryTimes {
+		if currentAttemptNum > 0 {
+			lastSleep = nextBackoff(cfg.RetryPolicy, lastSleep)
+			time.Sleep(lastSleep)
+		}
+
+		attemptNum++
+		logIfTest("[%s] TCP dial %s (attempt %d/%d)", serviceName, cfg.ParsedURL, currentAttemptNum+1, maxRetryTimes)
+
+		reqStartTime := time.Now()
+		conn, err := dialer.DialContext(ctx, "tcp", cfg.ParsedURL)
+		responseTime := time.Since(reqStartTime)
+		if err != nil {
+			failureDetails = append(failureDetails, fmt.Sprintf("TCP dial error: %s", err.Error()))
+			log.Printf("FAILED - TCP dial error: %s", err.Error())
+			publishAttempt(serviceName, cfg.URL, attemptNum, 0, responseTime, certRemainingDays, failureDetails[len(failureDetails)-1:])
+			if !shouldRetry(cfg.RetryPolicy, 0, err) {
+				break
+			}
+			continue
+		}
+
+		if cfg.TLS.Enabled {
+			remainingDays, expired, err := checkSSLCertificates(cfg.ParsedURL)
+			if err != nil {
+				failureDetails = append(failureDetails, fmt.Sprintf("TLS handshake error: %s", err.Error()))
+				_ = conn.Close()
+				publishAttempt(serviceName, cfg.URL, attemptNum, 0, responseTime, certRemainingDays, failureDetails[len(failureDetails)-1:])
+				if !shouldRetry(cfg.RetryPolicy, 0, err) {
+					break
+				}
+				continue
+			}
+			certRemainingDays = remainingDays
+			isCertExpired = expired
+
+			// Reuse the same pin/issuer/SAN/min-version posture checks the http
+			// Prober applies, so a tcp+tls endpoint can't bypass tls.* config by
+			// only ever hitting checkSSLCertificates' expiry check.
+			_, warn, postureErr := inspectTLSChain("https://"+cfg.ParsedURL, cfg)
+			if postureErr != nil {
+				failureDetails = append(failureDetails, fmt.Sprintf("TLS Posture Error: %s", postureErr.Error()))
+				_ = conn.Close()
+				publishAttempt(serviceName, cfg.URL, attemptNum, 0, responseTime, certRemainingDays, failureDetails[len(failureDetails)-1:])
+				if !shouldRetry(cfg.RetryPolicy, 0, postureErr) {
+					break
+				}
+				continue
+			}
+			tlsDegraded = warn
+		}
+
+		_ = conn.Close()
+		successNum++
+		if responseTime > maxResponseTime {
+			maxResponseTime = responseTime
+		}
+		logIfTest("SUCCESS - TCP %s (attempt %d/%d) - Connect Time: %d ms",
+			cfg.ParsedURL, currentAttemptNum+1, maxRetryTimes, responseTime.Milliseconds())
+		publishAttempt(serviceName, cfg.URL, attemptNum, 0, responseTime, certRemainingDays, nil)
+		break
+	}
+	endTime := time.Now()
+
+	status := getTestResult(successNum, attemptNum)
+	if tlsDegraded && status == chk_result.ALL {
+		// Certificate is within tls.warn_days of expiring: degrade rather than fail outright
+		status = chk_result.PART
+	}
+
+	return checker.Endpoint{
+		URL:               cfg.URL,
+		Method:            "TCP",
+		Status:            status,
+		StartTime:         startTime.Format(time.RFC3339),
+		EndTime:           endTime.Format(time.RFC3339),
+		ResponseTime:      maxResponseTime,
+		AttemptNum:        attemptNum,
+		SuccessNum:        successNum,
+		FailureDetails:    failureDetails,
+		IsHTTPS:           cfg.TLS.Enabled,
+		CertRemainingDays: certRemainingDays,
+		IsCertExpired:     isCertExpired,
+	}
+}
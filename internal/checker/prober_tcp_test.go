@@ -0,0 +1,56 @@
+package checker
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/wcy-dt/ponghub/internal/types/structures/configure"
+	"github.com/wcy-dt/ponghub/internal/types/types/chk_result"
+)
+
+func TestTCPProberSucceedsAgainstAListeningPort(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start test listener: %v", err)
+	}
+	defer func() { _ = ln.Close() }()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.Close()
+		}
+	}()
+
+	cfg := &configure.Endpoint{URL: ln.Addr().String(), ParsedURL: ln.Addr().String(), Type: "tcp"}
+	result := tcpProber{}.Probe(context.Background(), cfg, 1, 1, "test-service")
+
+	if result.Status != chk_result.ALL {
+		t.Fatalf("Status = %v, want %v; failures: %v", result.Status, chk_result.ALL, result.FailureDetails)
+	}
+	if result.SuccessNum != 1 {
+		t.Fatalf("SuccessNum = %d, want 1", result.SuccessNum)
+	}
+}
+
+func TestTCPProberFailsAgainstAClosedPort(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to allocate a port: %v", err)
+	}
+	addr := ln.Addr().String()
+	_ = ln.Close()
+
+	cfg := &configure.Endpoint{URL: addr, ParsedURL: addr, Type: "tcp"}
+	result := tcpProber{}.Probe(context.Background(), cfg, 1, 1, "test-service")
+
+	if result.Status == chk_result.ALL {
+		t.Fatal("dialing a closed port should not report success")
+	}
+	if len(result.FailureDetails) == 0 {
+		t.Fatal("expected a failure detail describing the dial error")
+	}
+}
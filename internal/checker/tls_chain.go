@@ -0,0 +1,170 @@
+package checker
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/wcy-dt/ponghub/internal/types/structures/checker"
+	"github.com/wcy-dt/ponghub/internal/types/structures/configure"
+)
+
+// inspectTLSChain dials targetURL, captures the full verified certificate chain,
+// and evaluates it against cfg's TLS posture knobs (tls.pin_sha256, tls.min_version,
+// tls.expected_issuer_regex, tls.expected_san_regex). It returns the captured chain,
+// whether the chain is within cfg.TLS.WarnDays of expiring (a degrade, not a failure),
+// and a non-nil error, which should fail the check, for a posture violation or when
+// targetURL cannot be parsed or the TLS dial fails.
+func inspectTLSChain(targetURL string, cfg *configure.Endpoint) (checker.TLSInfo, bool, error) {
+	hostPort, host, err := splitTargetURL(targetURL)
+	if err != nil {
+		return checker.TLSInfo{}, false, err
+	}
+
+	minVersion, hasMinVersion := parseTLSVersion(cfg.TLS.MinVersion)
+	dialConfig := &tls.Config{ServerName: host}
+	if hasMinVersion {
+		dialConfig.MinVersion = minVersion
+	}
+
+	conn, err := tls.Dial("tcp", hostPort, dialConfig)
+	if err != nil {
+		return checker.TLSInfo{}, false, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	state := conn.ConnectionState()
+	info := checker.TLSInfo{MinVersionOK: true}
+	if hasMinVersion && state.Version < minVersion {
+		info.MinVersionOK = false
+	}
+
+	var earliestExpiry time.Time
+	for _, cert := range state.PeerCertificates {
+		ci := checker.CertInfo{
+			Subject:            cert.Subject.String(),
+			Issuer:             cert.Issuer.String(),
+			SANs:               cert.DNSNames,
+			NotBefore:          cert.NotBefore,
+			NotAfter:           cert.NotAfter,
+			SignatureAlgorithm: cert.SignatureAlgorithm.String(),
+			KeyType:            publicKeyType(cert),
+			KeySize:            publicKeySize(cert),
+			SHA256Fingerprint:  hex.EncodeToString(sha256Sum(cert.Raw)),
+		}
+		info.Chain = append(info.Chain, ci)
+
+		if earliestExpiry.IsZero() || cert.NotAfter.Before(earliestExpiry) {
+			earliestExpiry = cert.NotAfter
+		}
+		if cfg.TLS.ExpectedIssuerRegex != "" {
+			if matched, _ := regexp.MatchString(cfg.TLS.ExpectedIssuerRegex, ci.Issuer); matched {
+				info.IssuerMatched = true
+			}
+		}
+		if cfg.TLS.ExpectedSANRegex != "" {
+			for _, san := range ci.SANs {
+				if matched, _ := regexp.MatchString(cfg.TLS.ExpectedSANRegex, san); matched {
+					info.SANMatched = true
+					break
+				}
+			}
+		}
+	}
+
+	if len(cfg.TLS.PinSHA256) > 0 {
+		for _, ci := range info.Chain {
+			for _, pin := range cfg.TLS.PinSHA256 {
+				if strings.EqualFold(ci.SHA256Fingerprint, pin) {
+					info.PinMatched = true
+				}
+			}
+		}
+	}
+
+	warnDegraded := cfg.TLS.WarnDays > 0 && !earliestExpiry.IsZero() &&
+		time.Until(earliestExpiry) <= time.Duration(cfg.TLS.WarnDays)*24*time.Hour
+
+	switch {
+	case len(cfg.TLS.PinSHA256) > 0 && !info.PinMatched:
+		return info, warnDegraded, fmt.Errorf("no certificate in chain matched the configured tls.pin_sha256 set")
+	case hasMinVersion && !info.MinVersionOK:
+		return info, warnDegraded, fmt.Errorf("negotiated TLS version is below tls.min_version")
+	case cfg.TLS.ExpectedIssuerRegex != "" && !info.IssuerMatched:
+		return info, warnDegraded, fmt.Errorf("no certificate issuer matched tls.expected_issuer_regex")
+	case cfg.TLS.ExpectedSANRegex != "" && !info.SANMatched:
+		return info, warnDegraded, fmt.Errorf("no SAN matched tls.expected_san_regex")
+	}
+
+	return info, warnDegraded, nil
+}
+
+// splitTargetURL extracts a dialable host:port and a bare hostname (for SNI) from a URL
+func splitTargetURL(targetURL string) (hostPort string, host string, err error) {
+	parsed, err := url.Parse(targetURL)
+	if err != nil {
+		return "", "", err
+	}
+
+	host = parsed.Hostname()
+	port := parsed.Port()
+	if port == "" {
+		port = "443"
+	}
+	return net.JoinHostPort(host, port), host, nil
+}
+
+// parseTLSVersion maps a "1.0".."1.3" config string to a crypto/tls version constant
+func parseTLSVersion(version string) (uint16, bool) {
+	switch version {
+	case "1.0":
+		return tls.VersionTLS10, true
+	case "1.1":
+		return tls.VersionTLS11, true
+	case "1.2":
+		return tls.VersionTLS12, true
+	case "1.3":
+		return tls.VersionTLS13, true
+	default:
+		return 0, false
+	}
+}
+
+// publicKeyType returns a short name for a certificate's public key algorithm
+func publicKeyType(cert *x509.Certificate) string {
+	switch cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		return "RSA"
+	case *ecdsa.PublicKey:
+		return "ECDSA"
+	default:
+		return cert.PublicKeyAlgorithm.String()
+	}
+}
+
+// publicKeySize returns a certificate's public key size in bits, where known
+func publicKeySize(cert *x509.Certificate) int {
+	switch key := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		return key.N.BitLen()
+	case *ecdsa.PublicKey:
+		return key.Curve.Params().BitSize
+	default:
+		return 0
+	}
+}
+
+// sha256Sum returns the SHA-256 digest of raw
+func sha256Sum(raw []byte) []byte {
+	sum := sha256.Sum256(raw)
+	return sum[:]
+}
@@ -0,0 +1,54 @@
+package checker
+
+import (
+	"crypto/tls"
+	"testing"
+)
+
+func TestParseTLSVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		want    uint16
+		wantOK  bool
+	}{
+		{version: "1.0", want: tls.VersionTLS10, wantOK: true},
+		{version: "1.1", want: tls.VersionTLS11, wantOK: true},
+		{version: "1.2", want: tls.VersionTLS12, wantOK: true},
+		{version: "1.3", want: tls.VersionTLS13, wantOK: true},
+		{version: "", wantOK: false},
+		{version: "2.0", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseTLSVersion(tt.version)
+		if ok != tt.wantOK {
+			t.Fatalf("parseTLSVersion(%q) ok = %v, want %v", tt.version, ok, tt.wantOK)
+		}
+		if ok && got != tt.want {
+			t.Fatalf("parseTLSVersion(%q) = %v, want %v", tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestSplitTargetURLDefaultsToPort443(t *testing.T) {
+	hostPort, host, err := splitTargetURL("https://example.com/health")
+	if err != nil {
+		t.Fatalf("splitTargetURL returned unexpected error: %v", err)
+	}
+	if host != "example.com" {
+		t.Fatalf("splitTargetURL host = %q, want %q", host, "example.com")
+	}
+	if hostPort != "example.com:443" {
+		t.Fatalf("splitTargetURL hostPort = %q, want %q", hostPort, "example.com:443")
+	}
+}
+
+func TestSplitTargetURLHonorsExplicitPort(t *testing.T) {
+	hostPort, _, err := splitTargetURL("https://example.com:8443/health")
+	if err != nil {
+		t.Fatalf("splitTargetURL returned unexpected error: %v", err)
+	}
+	if hostPort != "example.com:8443" {
+		t.Fatalf("splitTargetURL hostPort = %q, want %q", hostPort, "example.com:8443")
+	}
+}
@@ -0,0 +1,134 @@
+// Package events provides a small in-process event bus that downstream
+// subscribers (an NDJSON file, an SSE endpoint) can use to observe ponghub's
+// checks as they happen, instead of polling the static JSON log on every cycle.
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// Event describes a single observed outcome, either one probe attempt or the
+// merged result for a service/endpoint once all attempts have completed.
+type Event struct {
+	ID                int64    `json:"id"`
+	Service           string   `json:"service"`
+	URL               string   `json:"url"`
+	Attempt           int      `json:"attempt"`
+	StatusCode        int      `json:"status_code,omitempty"`
+	ResponseTimeMs    int64    `json:"response_time_ms"`
+	CertRemainingDays int      `json:"cert_remaining_days,omitempty"`
+	FailureDetails    []string `json:"failure_details,omitempty"`
+	Final             bool     `json:"final,omitempty"`
+}
+
+// Bus fans published events out to live subscribers, keeps a ring buffer for
+// reconnect-with-replay, and optionally appends every event to an NDJSON file.
+type Bus struct {
+	mu          sync.Mutex
+	nextID      int64
+	ring        []Event
+	ringSize    int
+	subscribers map[int64]chan Event
+	nextSubID   int64
+	file        *os.File
+}
+
+// NewBus creates a Bus that retains up to ringSize events for replay
+func NewBus(ringSize int) *Bus {
+	if ringSize <= 0 {
+		ringSize = 1000
+	}
+	return &Bus{
+		ringSize:    ringSize,
+		subscribers: make(map[int64]chan Event),
+	}
+}
+
+// OpenFile appends every published event to path as newline-delimited JSON
+func (b *Bus) OpenFile(path string) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("opening events file: %w", err)
+	}
+
+	b.mu.Lock()
+	b.file = f
+	b.mu.Unlock()
+	return nil
+}
+
+// Close releases the NDJSON file, if one was opened
+func (b *Bus) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.file == nil {
+		return nil
+	}
+	return b.file.Close()
+}
+
+// Publish assigns the next event ID, records it in the ring buffer, appends it
+// to the NDJSON file if configured, and fans it out to all live subscribers.
+// Subscribers that are not keeping up are skipped rather than blocking Publish.
+func (b *Bus) Publish(e Event) {
+	b.mu.Lock()
+	b.nextID++
+	e.ID = b.nextID
+
+	b.ring = append(b.ring, e)
+	if len(b.ring) > b.ringSize {
+		b.ring = b.ring[len(b.ring)-b.ringSize:]
+	}
+
+	if b.file != nil {
+		if line, err := json.Marshal(e); err == nil {
+			_, _ = b.file.Write(append(line, '\n'))
+		}
+	}
+
+	subs := make([]chan Event, 0, len(b.subscribers))
+	for _, ch := range b.subscribers {
+		subs = append(subs, ch)
+	}
+	b.mu.Unlock()
+
+	for _, ch := range subs {
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+}
+
+// Subscribe registers a new live subscriber and returns its ID, its channel,
+// and a backlog of ring-buffered events with ID greater than lastEventID so a
+// reconnecting client can replay what it missed.
+func (b *Bus) Subscribe(lastEventID int64) (id int64, ch chan Event, backlog []Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.nextSubID++
+	id = b.nextSubID
+	ch = make(chan Event, 64)
+	b.subscribers[id] = ch
+
+	for _, e := range b.ring {
+		if e.ID > lastEventID {
+			backlog = append(backlog, e)
+		}
+	}
+	return id, ch, backlog
+}
+
+// Unsubscribe removes a subscriber and closes its channel
+func (b *Bus) Unsubscribe(id int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if ch, ok := b.subscribers[id]; ok {
+		delete(b.subscribers, id)
+		close(ch)
+	}
+}
@@ -0,0 +1,91 @@
+package events
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBusPublishAssignsIncrementingIDs(t *testing.T) {
+	bus := NewBus(10)
+	bus.Publish(Event{Service: "a"})
+	bus.Publish(Event{Service: "b"})
+
+	if len(bus.ring) != 2 {
+		t.Fatalf("ring length = %d, want 2", len(bus.ring))
+	}
+	if bus.ring[0].ID != 1 || bus.ring[1].ID != 2 {
+		t.Fatalf("event IDs = %d, %d, want 1, 2", bus.ring[0].ID, bus.ring[1].ID)
+	}
+}
+
+func TestBusRingBufferTrimsToSize(t *testing.T) {
+	bus := NewBus(3)
+	for i := 0; i < 5; i++ {
+		bus.Publish(Event{Service: "svc"})
+	}
+
+	if len(bus.ring) != 3 {
+		t.Fatalf("ring length = %d, want 3", len(bus.ring))
+	}
+	if bus.ring[0].ID != 3 {
+		t.Fatalf("oldest retained event ID = %d, want 3", bus.ring[0].ID)
+	}
+}
+
+func TestBusSubscribeReplaysBacklogAfterLastEventID(t *testing.T) {
+	bus := NewBus(10)
+	bus.Publish(Event{Service: "a"})
+	bus.Publish(Event{Service: "b"})
+	bus.Publish(Event{Service: "c"})
+
+	id, ch, backlog := bus.Subscribe(1)
+	defer bus.Unsubscribe(id)
+
+	if len(backlog) != 2 {
+		t.Fatalf("backlog length = %d, want 2 (events with ID > 1)", len(backlog))
+	}
+	if backlog[0].Service != "b" || backlog[1].Service != "c" {
+		t.Fatalf("backlog = %+v, want [b, c]", backlog)
+	}
+
+	bus.Publish(Event{Service: "d"})
+	select {
+	case e := <-ch:
+		if e.Service != "d" {
+			t.Fatalf("received event %+v, want Service=d", e)
+		}
+	default:
+		t.Fatal("expected the new event to be delivered to the live subscriber")
+	}
+}
+
+func TestBusUnsubscribeClosesChannel(t *testing.T) {
+	bus := NewBus(10)
+	id, ch, _ := bus.Subscribe(0)
+	bus.Unsubscribe(id)
+
+	if _, open := <-ch; open {
+		t.Fatal("channel should be closed after Unsubscribe")
+	}
+}
+
+func TestBusOpenFileAppendsNDJSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.ndjson")
+	bus := NewBus(10)
+	if err := bus.OpenFile(path); err != nil {
+		t.Fatalf("OpenFile returned unexpected error: %v", err)
+	}
+	defer func() { _ = bus.Close() }()
+
+	bus.Publish(Event{Service: "a"})
+	bus.Publish(Event{Service: "b"})
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read events file: %v", err)
+	}
+	if got := string(data); got == "" {
+		t.Fatal("expected the events file to contain appended NDJSON lines")
+	}
+}
@@ -0,0 +1,65 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// marshalEvent renders e as the compact JSON payload carried by one SSE frame
+func marshalEvent(e Event) ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// ServeHTTP implements the /events Server-Sent Events endpoint: it replays any
+// buffered events newer than the Last-Event-ID header, then streams new events
+// as they're published until the client disconnects.
+func (b *Bus) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	lastEventID, _ := strconv.ParseInt(r.Header.Get("Last-Event-ID"), 10, 64)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	id, ch, backlog := b.Subscribe(lastEventID)
+	defer b.Unsubscribe(id)
+
+	for _, e := range backlog {
+		if !writeSSE(w, e) {
+			return
+		}
+	}
+	flusher.Flush()
+
+	for {
+		select {
+		case e, open := <-ch:
+			if !open {
+				return
+			}
+			if !writeSSE(w, e) {
+				return
+			}
+			flusher.Flush()
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// writeSSE writes a single event as one SSE frame, reporting whether it succeeded
+func writeSSE(w http.ResponseWriter, e Event) bool {
+	payload, err := marshalEvent(e)
+	if err != nil {
+		return false
+	}
+	_, err = fmt.Fprintf(w, "id: %d\ndata: %s\n\n", e.ID, payload)
+	return err == nil
+}
@@ -0,0 +1,48 @@
+package events
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteSSEFormatsFrame(t *testing.T) {
+	rec := httptest.NewRecorder()
+	if !writeSSE(rec, Event{ID: 7, Service: "svc"}) {
+		t.Fatal("writeSSE returned false, want true")
+	}
+
+	body := rec.Body.String()
+	if !strings.HasPrefix(body, "id: 7\ndata: ") {
+		t.Fatalf("frame = %q, want it to start with %q", body, "id: 7\ndata: ")
+	}
+	if !strings.HasSuffix(body, "\n\n") {
+		t.Fatalf("frame = %q, want it to end with a blank line", body)
+	}
+}
+
+func TestServeHTTPReplaysBacklogOnLastEventID(t *testing.T) {
+	bus := NewBus(10)
+	bus.Publish(Event{Service: "a"})
+	bus.Publish(Event{Service: "b"})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req := httptest.NewRequest(http.MethodGet, "/events", nil).WithContext(ctx)
+	req.Header.Set("Last-Event-ID", "1")
+	rec := httptest.NewRecorder()
+
+	bus.ServeHTTP(rec, req)
+
+	body := rec.Body.String()
+	if !strings.Contains(body, `"service":"b"`) {
+		t.Fatalf("response body = %q, want it to contain the event published after Last-Event-ID", body)
+	}
+	if strings.Contains(body, `"service":"a"`) {
+		t.Fatalf("response body = %q, want it to exclude the event at Last-Event-ID", body)
+	}
+}
@@ -0,0 +1,151 @@
+// Package exporter exposes ponghub's endpoint checks as a Prometheus-compatible
+// HTTP endpoint, modeled on the blackbox_exporter pattern: a long-running /metrics
+// endpoint for self metrics, and an on-demand /probe endpoint that triggers a
+// single check and renders it in the Prometheus text exposition format.
+package exporter
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/wcy-dt/ponghub/internal/checker"
+	"github.com/wcy-dt/ponghub/internal/events"
+	"github.com/wcy-dt/ponghub/internal/logger"
+	"github.com/wcy-dt/ponghub/internal/types/structures/configure"
+)
+
+// Server exposes /metrics and /probe for Prometheus to scrape, plus the /events
+// live event feed
+type Server struct {
+	addr          string
+	timeout       int
+	maxRetryTimes int
+	modules       map[string]*configure.Endpoint
+	startTime     time.Time
+	bus           *events.Bus
+
+	mu           sync.Mutex
+	probesTotal  int64
+	probesFailed int64
+}
+
+// NewServer creates a Server listening on addr. modules maps a Prometheus scrape
+// job's `module` parameter to the YAML endpoint configuration it should reuse
+// (StatusCode, ResponseRegex, ParsedHeaders, ...); the `target` query parameter
+// always overrides the module's URL. eventsRingSize and eventsFilePath configure
+// the live event feed served on /events, which is always enabled: an
+// eventsRingSize <= 0 uses the event bus's default ring size, and an empty
+// eventsFilePath only disables the NDJSON file.
+func NewServer(addr string, timeout int, maxRetryTimes int, modules map[string]*configure.Endpoint, eventsRingSize int, eventsFilePath string) *Server {
+	bus := events.NewBus(eventsRingSize)
+	if eventsFilePath != "" {
+		if err := bus.OpenFile(eventsFilePath); err != nil {
+			log.Printf("exporter: could not open events file %s: %v", eventsFilePath, err)
+		}
+	}
+	checker.SetEventBus(bus)
+	logger.SetEventBus(bus)
+
+	return &Server{
+		addr:          addr,
+		timeout:       timeout,
+		maxRetryTimes: maxRetryTimes,
+		modules:       modules,
+		startTime:     time.Now(),
+		bus:           bus,
+	}
+}
+
+// ListenAndServe starts the HTTP server, blocking until it stops or errors
+func (s *Server) ListenAndServe() error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", s.handleMetrics)
+	mux.HandleFunc("/probe", s.handleProbe)
+	mux.Handle("/events", s.bus)
+
+	log.Printf("exporter: listening on %s", s.addr)
+	return http.ListenAndServe(s.addr, mux)
+}
+
+// handleMetrics reports the exporter's own health, separate from any probe result
+func (s *Server) handleMetrics(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	total, failed := s.probesTotal, s.probesFailed
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	writeMetric(w, "ponghub_exporter_uptime_seconds", "gauge", "Time since the exporter started, in seconds", time.Since(s.startTime).Seconds())
+	writeMetric(w, "ponghub_exporter_probes_total", "counter", "Total number of /probe requests handled", float64(total))
+	writeMetric(w, "ponghub_exporter_probes_failed_total", "counter", "Total number of /probe requests whose target failed", float64(failed))
+}
+
+// handleProbe triggers checkEndpoint on demand for ?target=<url>&module=<name>
+func (s *Server) handleProbe(w http.ResponseWriter, r *http.Request) {
+	target := r.URL.Query().Get("target")
+	if target == "" {
+		http.Error(w, "target parameter is required", http.StatusBadRequest)
+		return
+	}
+
+	cfg := s.resolveModule(r.URL.Query().Get("module"), target)
+
+	start := time.Now()
+	result := checker.CheckEndpoint(cfg, s.timeout, s.maxRetryTimes, target)
+	duration := time.Since(start)
+
+	s.mu.Lock()
+	s.probesTotal++
+	if result.SuccessNum == 0 {
+		s.probesFailed++
+	}
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	writeProbeMetrics(w, result, duration)
+}
+
+// resolveModule looks up a named module, falling back to a bare GET of target
+func (s *Server) resolveModule(module, target string) *configure.Endpoint {
+	if module != "" {
+		if cfg, ok := s.modules[module]; ok {
+			resolved := *cfg
+			resolved.URL = target
+			resolved.ParsedURL = target
+			return &resolved
+		}
+	}
+	return &configure.Endpoint{URL: target, ParsedURL: target, Method: "GET"}
+}
+
+// writeProbeMetrics renders a checkEndpoint result as blackbox_exporter-style probe_* metrics
+func writeProbeMetrics(w http.ResponseWriter, result checker.Endpoint, duration time.Duration) {
+	success := float64(0)
+	if result.SuccessNum > 0 {
+		success = 1
+	}
+
+	writeMetric(w, "probe_success", "gauge", "Displays whether or not the probe was a success", success)
+	writeMetric(w, "probe_duration_seconds", "gauge", "Returns how long the probe took to complete in seconds", duration.Seconds())
+	writeMetric(w, "probe_http_status_code", "gauge", "Response HTTP status code", float64(result.StatusCode))
+	writeMetric(w, "probe_http_content_length", "gauge", "Length of the HTTP response body", float64(len(result.ResponseBody)))
+	writeMetric(w, "probe_attempts_total", "counter", "Total number of attempts made against the target", float64(result.AttemptNum))
+	writeMetric(w, "probe_attempts_success_total", "counter", "Total number of successful attempts against the target", float64(result.SuccessNum))
+
+	if result.IsHTTPS {
+		// probe_ssl_earliest_cert_expiry mirrors blackbox_exporter's own metric of
+		// this name: a Unix timestamp, not a day count, so alert rules like
+		// `(probe_ssl_earliest_cert_expiry - time()) < 86400*14` work unmodified.
+		expiry := time.Now().Add(time.Duration(result.CertRemainingDays) * 24 * time.Hour)
+		writeMetric(w, "probe_ssl_earliest_cert_expiry", "gauge", "Returns last TLS chain expiry in Unix time", float64(expiry.Unix()))
+		writeMetric(w, "probe_ssl_last_chain_expiry_timestamp_seconds", "gauge", "Last TLS chain expiry as a Unix timestamp", float64(expiry.Unix()))
+	}
+}
+
+// writeMetric writes a single HELP/TYPE/value triplet in Prometheus text exposition format
+func writeMetric(w http.ResponseWriter, name, typ, help string, value float64) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n%s %s\n", name, help, name, typ, name, strconv.FormatFloat(value, 'g', -1, 64))
+}
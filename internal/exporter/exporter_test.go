@@ -0,0 +1,78 @@
+package exporter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/wcy-dt/ponghub/internal/types/structures/configure"
+)
+
+func TestWriteMetricFormatsExpositionTriplet(t *testing.T) {
+	rec := httptest.NewRecorder()
+	writeMetric(rec, "probe_success", "gauge", "Displays whether or not the probe was a success", 1)
+
+	body := rec.Body.String()
+	want := "# HELP probe_success Displays whether or not the probe was a success\n" +
+		"# TYPE probe_success gauge\n" +
+		"probe_success 1\n"
+	if body != want {
+		t.Fatalf("writeMetric output = %q, want %q", body, want)
+	}
+}
+
+func TestResolveModuleFallsBackToBareGetForUnknownModule(t *testing.T) {
+	s := &Server{modules: map[string]*configure.Endpoint{}}
+	cfg := s.resolveModule("missing", "https://example.com")
+
+	if cfg.Method != "GET" || cfg.URL != "https://example.com" {
+		t.Fatalf("resolveModule = %+v, want a bare GET of the target", cfg)
+	}
+}
+
+func TestResolveModuleOverridesTargetOnKnownModule(t *testing.T) {
+	s := &Server{modules: map[string]*configure.Endpoint{
+		"http_2xx": {Method: "GET", StatusCode: 200},
+	}}
+	cfg := s.resolveModule("http_2xx", "https://example.com/health")
+
+	if cfg.URL != "https://example.com/health" || cfg.ParsedURL != "https://example.com/health" {
+		t.Fatalf("resolveModule did not override the target URL: %+v", cfg)
+	}
+	if cfg.StatusCode != 200 {
+		t.Fatalf("resolveModule lost the module's configured StatusCode: %+v", cfg)
+	}
+}
+
+func TestHandleProbeRequiresTargetParameter(t *testing.T) {
+	s := NewServer(":0", 1, 1, nil, 0, "")
+	req := httptest.NewRequest(http.MethodGet, "/probe", nil)
+	rec := httptest.NewRecorder()
+
+	s.handleProbe(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandleProbeAgainstALiveTarget(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer target.Close()
+
+	s := NewServer(":0", 1, 1, nil, 0, "")
+	req := httptest.NewRequest(http.MethodGet, "/probe?target="+target.URL, nil)
+	rec := httptest.NewRecorder()
+
+	s.handleProbe(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if !strings.Contains(rec.Body.String(), "probe_success 1") {
+		t.Fatalf("body = %q, want it to report probe_success 1", rec.Body.String())
+	}
+}
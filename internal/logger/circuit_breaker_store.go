@@ -0,0 +1,46 @@
+package logger
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/wcy-dt/ponghub/internal/checker"
+)
+
+// breakerStatePath derives the circuit breaker sidecar path from the main log path
+func breakerStatePath(logPath string) string {
+	if strings.HasSuffix(logPath, ".json") {
+		return strings.TrimSuffix(logPath, ".json") + ".breakers.json"
+	}
+	return logPath + ".breakers.json"
+}
+
+// LoadBreakerStates reads the circuit breaker sidecar file written by a previous
+// run, if any, and seeds checker's in-memory breaker registry so an open breaker
+// survives a process restart instead of silently resetting to closed.
+func LoadBreakerStates(logPath string) error {
+	content, err := os.ReadFile(breakerStatePath(logPath))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	var states []checker.BreakerState
+	if err := json.Unmarshal(content, &states); err != nil {
+		return err
+	}
+	checker.LoadBreakerStates(states)
+	return nil
+}
+
+// saveBreakerStates writes checker's current breaker registry to the sidecar file
+func saveBreakerStates(logPath string) error {
+	content, err := json.MarshalIndent(checker.SnapshotBreakerStates(), "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(breakerStatePath(logPath), content, 0644)
+}
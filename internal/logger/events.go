@@ -0,0 +1,39 @@
+package logger
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wcy-dt/ponghub/internal/events"
+	"github.com/wcy-dt/ponghub/internal/types/types/test_result"
+)
+
+// eventBus receives per-service results, if configured
+var eventBus *events.Bus
+
+// SetEventBus registers the bus that merged service results are published to.
+// Passing nil (the default) disables publishing entirely.
+func SetEventBus(bus *events.Bus) {
+	eventBus = bus
+}
+
+// publishServiceEvent emits a service/URL's merged status for this cycle to the event bus
+func publishServiceEvent(serviceName, url string, attemptNum int, status test_result.TestResult, responseTime time.Duration) {
+	if eventBus == nil {
+		return
+	}
+
+	var failureDetails []string
+	if status != test_result.ALL {
+		failureDetails = []string{fmt.Sprintf("status: %s", status.String())}
+	}
+
+	eventBus.Publish(events.Event{
+		Service:        serviceName,
+		URL:            url,
+		Attempt:        attemptNum,
+		ResponseTimeMs: responseTime.Milliseconds(),
+		FailureDetails: failureDetails,
+		Final:          true,
+	})
+}
@@ -7,9 +7,15 @@ import (
 	"github.com/wcy-dt/ponghub/internal/types/types/test_result"
 	"log"
 	"os"
+	"sync"
 	"time"
 )
 
+// loadBreakerStatesOnce seeds the in-memory circuit breaker registry from the
+// sidecar file the first time OutputResults runs, so a breaker that was open
+// when the process last exited stays open instead of resetting to closed.
+var loadBreakerStatesOnce sync.Once
+
 // mergeOnlineStatus merges multiple statuses into a single status
 func mergeOnlineStatus(statuses []test_result.TestResult) test_result.TestResult {
 	if len(statuses) == 0 {
@@ -100,6 +106,12 @@ func processCheckResult(svc checker.Checker) (map[string][]test_result.TestResul
 
 // OutputResults writes check results to JSON file
 func OutputResults(results []checker.Checker, maxLogDays int, logPath string) (logger.Logger, error) {
+	loadBreakerStatesOnce.Do(func() {
+		if err := LoadBreakerStates(logPath); err != nil {
+			log.Printf("Error loading circuit breaker states for %s: %v", logPath, err)
+		}
+	})
+
 	logData, err := LoadExistingLog(logPath)
 	if err != nil {
 		log.Printf("Error loading log data from %s: %v", logPath, err)
@@ -134,6 +146,8 @@ func OutputResults(results []checker.Checker, maxLogDays int, logPath string) (l
 				ResponseTime: int(urlResponseTimeMap[url].Milliseconds()),
 			}
 
+			publishServiceEvent(serviceName, url, len(statuses), mergedStatus, urlResponseTimeMap[url])
+
 			tmp := serviceLog.PortsData[url]
 			tmp.AddEntry(newEntry)
 			tmp.CleanExpiredEntries(maxLogDays)
@@ -149,5 +163,9 @@ func OutputResults(results []checker.Checker, maxLogDays int, logPath string) (l
 		return nil, err
 	}
 
+	if err := saveBreakerStates(logPath); err != nil {
+		log.Printf("Error saving circuit breaker states for %s: %v", logPath, err)
+	}
+
 	return logData, nil
 }
@@ -37,8 +37,32 @@ type (
 		IsHTTPS           bool                   `json:"is_https,omitempty"`
 		CertRemainingDays int                    `json:"cert_remaining_days,omitempty"`
 		IsCertExpired     bool                   `json:"is_cert_expired,omitempty"`
+		TLSInfo           *TLSInfo               `json:"tls_info,omitempty"`
 		// Highlight information for display
 		DisplayURL        string              `json:"display_url,omitempty"`
 		HighlightSegments []highlight.Segment `json:"highlight_segments,omitempty"`
 	}
+
+	// CertInfo describes a single certificate in a verified TLS chain
+	CertInfo struct {
+		Subject            string    `json:"subject"`
+		Issuer             string    `json:"issuer"`
+		SANs               []string  `json:"sans,omitempty"`
+		NotBefore          time.Time `json:"not_before"`
+		NotAfter           time.Time `json:"not_after"`
+		SignatureAlgorithm string    `json:"signature_algorithm"`
+		KeyType            string    `json:"key_type"`
+		KeySize            int       `json:"key_size"`
+		SHA256Fingerprint  string    `json:"sha256_fingerprint"`
+	}
+
+	// TLSInfo captures the full verified chain plus the outcome of evaluating it
+	// against the endpoint's TLS posture knobs (pinning, min version, issuer/SAN)
+	TLSInfo struct {
+		Chain         []CertInfo `json:"chain,omitempty"`
+		PinMatched    bool       `json:"pin_matched,omitempty"`
+		MinVersionOK  bool       `json:"min_version_ok"`
+		IssuerMatched bool       `json:"issuer_matched,omitempty"`
+		SANMatched    bool       `json:"san_matched,omitempty"`
+	}
 )
@@ -0,0 +1,54 @@
+// Package configure defines the parsed YAML configuration structures ponghub
+// checks endpoints against.
+package configure
+
+// TLSConfig groups the TLS handshake and certificate posture knobs for the tcp
+// and http Probers under a single nested `tls:` block, the same shape RetryPolicy
+// uses for `retry_policy:`.
+type TLSConfig struct {
+	// Enabled turns on a TLS handshake (and certificate check) on top of a
+	// plain TCP dial for the tcp Prober. Unused by the http Prober, which
+	// detects TLS from the URL scheme instead.
+	Enabled bool `yaml:"enabled"`
+	// PinSHA256 lists acceptable leaf/intermediate SHA-256 fingerprints; the
+	// check fails if no certificate in the chain matches
+	PinSHA256 []string `yaml:"pin_sha256"`
+	// MinVersion is the minimum acceptable negotiated TLS version ("1.0".."1.3")
+	MinVersion string `yaml:"min_version"`
+	// ExpectedIssuerRegex, if set, must match at least one certificate's issuer
+	ExpectedIssuerRegex string `yaml:"expected_issuer_regex"`
+	// ExpectedSANRegex, if set, must match at least one certificate's SAN
+	ExpectedSANRegex string `yaml:"expected_san_regex"`
+	// WarnDays degrades the endpoint to PART instead of failing it outright
+	// when the chain is within this many days of expiring
+	WarnDays int `yaml:"warn_days"`
+}
+
+// Endpoint is the parsed configuration for a single URL/port to check
+type Endpoint struct {
+	URL           string            `yaml:"url"`
+	ParsedURL     string            `yaml:"-"`
+	Method        string            `yaml:"method"`
+	Body          string            `yaml:"body"`
+	ParsedBody    string            `yaml:"-"`
+	ParsedHeaders map[string]string `yaml:"-"`
+	StatusCode    int               `yaml:"status_code"`
+	ResponseRegex string            `yaml:"response_regex"`
+
+	// Type selects the Prober used to check this endpoint: "http" (default),
+	// "tcp", "icmp", "dns", or "grpc"
+	Type string `yaml:"type"`
+	// Resolver is an optional "host:port" DNS server used by the dns Prober,
+	// overriding the system resolver
+	Resolver string `yaml:"resolver"`
+	// Service is the gRPC health service name checked by the grpc Prober
+	Service string `yaml:"service"`
+	// TLS configures the TLS handshake and certificate posture checks
+	TLS TLSConfig `yaml:"tls"`
+
+	// RetryPolicy paces the retry loop and, once FailureThreshold is set, gates
+	// this endpoint behind a per-URL circuit breaker. The zero value retries
+	// every failure with no breaker, pacing retries with the default backoff
+	// (100ms initial delay, 30s cap).
+	RetryPolicy RetryPolicy `yaml:"retry_policy"`
+}
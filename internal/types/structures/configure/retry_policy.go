@@ -0,0 +1,80 @@
+package configure
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Duration wraps time.Duration so YAML can write it as a human string like
+// "100ms" or "30s" (via time.ParseDuration) instead of only a raw nanosecond
+// integer, matching what every RetryPolicy field name implies users should write.
+type Duration time.Duration
+
+// Duration returns d as a plain time.Duration for arithmetic and comparisons
+func (d Duration) Duration() time.Duration {
+	return time.Duration(d)
+}
+
+// UnmarshalYAML accepts either a duration string ("100ms") or a bare integer
+// (interpreted as nanoseconds, for backward compatibility with plain YAML ints)
+func (d *Duration) UnmarshalYAML(value *yaml.Node) error {
+	// Integers are tried first: any scalar, including a bare integer, also
+	// decodes into a string, which ParseDuration would then reject.
+	var n int64
+	if err := value.Decode(&n); err == nil {
+		*d = Duration(n)
+		return nil
+	}
+
+	var s string
+	if err := value.Decode(&s); err != nil {
+		return fmt.Errorf("invalid duration %q: must be a duration string (e.g. \"100ms\") or an integer number of nanoseconds", value.Value)
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("invalid duration %q: %w", s, err)
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// Jitter selects how RetryPolicy spreads out consecutive retry delays
+type Jitter string
+
+const (
+	JitterFull  Jitter = "full"
+	JitterEqual Jitter = "equal"
+	JitterNone  Jitter = "none"
+)
+
+// StatusRange is an inclusive HTTP status code range that should be retried
+type StatusRange struct {
+	Min int `yaml:"min"`
+	Max int `yaml:"max"`
+}
+
+// RetryOn selects which outcomes of a probe attempt are considered transient
+// (and therefore retried) versus terminal. An empty RetryOn retries everything,
+// preserving the historical behavior of retrying every failure.
+type RetryOn struct {
+	StatusRanges []StatusRange `yaml:"status_ranges"`
+	Network      bool          `yaml:"network"`
+	Timeout      bool          `yaml:"timeout"`
+}
+
+// RetryPolicy paces an Endpoint's retry loop with decorrelated-jitter backoff
+// and, once enabled via FailureThreshold, trips a per-URL circuit breaker
+// instead of continuing to hammer a service that's already down.
+type RetryPolicy struct {
+	InitialDelay Duration `yaml:"initial_delay"`
+	MaxDelay     Duration `yaml:"max_delay"`
+	Multiplier   float64  `yaml:"multiplier"`
+	Jitter       Jitter   `yaml:"jitter"`
+	RetryOn      RetryOn  `yaml:"retry_on"`
+
+	// FailureThreshold <= 0 disables circuit breaking for this endpoint
+	FailureThreshold int      `yaml:"failure_threshold"`
+	OpenDuration     Duration `yaml:"open_duration"`
+}
@@ -0,0 +1,5 @@
+package chk_result
+
+// SKIPPED marks an endpoint whose circuit breaker was open, so no probe was
+// attempted this cycle
+const SKIPPED CheckResult = "SKIPPED"